@@ -3,6 +3,7 @@ package gcfg
 import (
 	"fmt"
 
+	"github.com/launchdarkly/gcfg/scanner"
 	"github.com/launchdarkly/gcfg/token"
 )
 
@@ -34,10 +35,15 @@ const (
 //
 // If all three fields are empty (for InvalidContainerError), the problem is with the
 // target data structure that was passed to the Read function.
+//
+// Position is the location in the configuration data, in the style of go/scanner, that
+// the problem was found at. The Read functions set it to the position of the section header
+// or variable name that the error concerns.
 type ErrorLocation struct {
 	Section    string
 	Subsection string
 	Field      string
+	token.Position
 }
 
 func (l ErrorLocation) describeLocation() string {
@@ -51,6 +57,30 @@ func (l ErrorLocation) describeLocation() string {
 	}
 }
 
+// withPosition returns err with pos attached, if err is a TargetNotFoundError, ValueError,
+// or InvalidContainerError (the three error types whose ErrorLocation carries a
+// token.Position). Any other error, including one that already has its own Position (such
+// as a ParseError), is returned unchanged.
+//
+// set() itself has no notion of token.Position, so readInto calls this at the point where
+// it already has the position of the section header or variable name in scope, rather than
+// plumbing a position argument into set().
+func withPosition(err error, pos token.Position) error {
+	switch e := err.(type) {
+	case TargetNotFoundError:
+		e.Position = pos
+		return e
+	case ValueError:
+		e.Position = pos
+		return e
+	case InvalidContainerError:
+		e.Position = pos
+		return e
+	default:
+		return err
+	}
+}
+
 // ParseError is an error that indicates that the configuration data had invalid syntax.
 // The Err value describes what the specific problem was.
 //
@@ -79,11 +109,11 @@ type TargetNotFoundError struct {
 func (e TargetNotFoundError) Error() string {
 	switch {
 	case e.Field != "":
-		return "invalid variable: " + e.describeLocation()
+		return fmt.Sprintf("%s: invalid variable: %s", e.Position, e.describeLocation())
 	case e.Subsection != "":
-		return "invalid subsection: " + e.describeLocation()
+		return fmt.Sprintf("%s: invalid subsection: %s", e.Position, e.describeLocation())
 	default:
-		return "invalid section: " + e.describeLocation()
+		return fmt.Sprintf("%s: invalid section: %s", e.Position, e.describeLocation())
 	}
 }
 
@@ -101,7 +131,7 @@ type ValueError struct {
 }
 
 func (e ValueError) Error() string {
-	return fmt.Sprintf("%s: %s", e.Err, e.describeLocation())
+	return fmt.Sprintf("%s: %s: %s", e.Position, e.Err, e.describeLocation())
 }
 
 // InvalidContainerError is an error that indicates that a section within the target data
@@ -117,7 +147,31 @@ type InvalidContainerError struct {
 }
 
 func (e InvalidContainerError) Error() string {
-	return fmt.Sprintf("%s: %s", e.Message, e.describeLocation())
+	return fmt.Sprintf("%s: %s: %s", e.Position, e.Message, e.describeLocation())
+}
+
+// ErrorList is a list of errors encountered while reading configuration data. It is
+// returned by the Read functions in place of a single error when the CollectErrors option
+// is used, so that all of the problems found in the input can be reported together instead
+// of stopping at the first one.
+//
+// ErrorList implements Unwrap() []error, so errors.Is and errors.As can be used to test
+// for or extract a specific error (such as a ValueError) from among the errors collected.
+type ErrorList []error
+
+func (l ErrorList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", l[0], len(l)-1)
+}
+
+// Unwrap returns the errors contained in the list, for use by errors.Is and errors.As.
+func (l ErrorList) Unwrap() []error {
+	return []error(l)
 }
 
 // StopOnTargetNotFound can be used with ErrorHandler to change gcfg's behavior regarding
@@ -139,6 +193,29 @@ func StopOnTargetNotFound(e error) ErrorAction {
 	return ErrorActionNone
 }
 
+// SuppressParseErrors can be used with ErrorHandler to change gcfg's behavior regarding
+// syntax errors.
+//
+// By default, a ParseError (or an underlying scanner.Error) causes gcfg to stop and return
+// the error to the caller immediately, since the rest of the file may be unparseable too.
+// If you specify ErrorHandler(SuppressParseErrors), gcfg will instead resync to the next
+// line (or section header) and keep going, the same way it does by default for
+// TargetNotFoundError.
+//
+// If you want to customize error-reporting behavior in other ways, use ErrorHandler with a
+// custom function.
+//
+//     err := gcfg.ReadFileInto(&configStruct, fileName,
+//         gcfg.ErrorHandler(gcfg.SuppressParseErrors))
+func SuppressParseErrors(e error) ErrorAction {
+	switch e.(type) {
+	case ParseError, scanner.Error, scanner.ErrorList:
+		return ErrorActionSuppress
+	default:
+		return ErrorActionNone
+	}
+}
+
 // defaultErrorHandler is the fallback handler that the Read functions use if there is no
 // custom handler, or if the custom handler(s) all returned ErrorActionNone.
 func defaultErrorHandler(e error) ErrorAction {
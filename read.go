@@ -79,130 +79,228 @@ func readInto(config interface{}, fset *token.FileSet, file *token.File, src []b
 	errfn := func(msg string) error {
 		return ParseError{Position: fset.Position(pos), Err: errors.New(msg)}
 	}
+
+	// errList accumulates errors when the CollectErrors option is in effect; it is left
+	// empty (and unused) otherwise.
+	var errList ErrorList
+
+	// fatal records a fatal error once it has been decided that gcfg will not continue past
+	// it. With the default behavior, it just returns err so the caller returns it
+	// immediately. With CollectErrors, it instead records err in errList and returns nil, so
+	// the caller resyncs the scanner and keeps going rather than stopping.
+	fatal := func(err error) error {
+		if !readOptions.collectErrors {
+			return err
+		}
+		errList = append(errList, err)
+		return nil
+	}
+
+	// report runs err through the ErrorHandler chain, exactly as set() errors already do. If
+	// no handler requests ErrorActionStop (for instance, a handler installed via
+	// ErrorHandler(SuppressParseErrors) returned ErrorActionSuppress), report returns nil and
+	// the caller resyncs and keeps going. Otherwise it defers to fatal.
+	report := func(err error) error {
+		if !processErrorAndMaybeStop(err) {
+			return nil
+		}
+		return fatal(err)
+	}
+
+	// resync skips forward to the next EOL or EOF, so that parsing can resume at the next
+	// line (which may be a section header) after a syntax error.
+	resync := func() {
+		for tok != token.EOL && tok != token.EOF {
+			pos, tok, lit = s.Scan()
+		}
+	}
+
 	for {
 		if errs.Len() > 0 {
-			return errs.Err()
+			scanErr := errs.Err()
+			errs = scanner.ErrorList{}
+			if err := report(scanErr); err != nil {
+				return err
+			}
+			resync()
+			continue
 		}
 		switch tok {
 		case token.EOF:
+			if len(errList) > 0 {
+				return errList
+			}
 			return nil
 		case token.EOL, token.COMMENT:
 			pos, tok, lit = s.Scan()
 		case token.LBRACK:
 			pos, tok, lit = s.Scan()
 			if errs.Len() > 0 {
-				return errs.Err()
+				continue
 			}
 			if tok != token.IDENT {
-				return errfn("expected section name")
+				if err := report(errfn("expected section name")); err != nil {
+					return err
+				}
+				resync()
+				continue
 			}
+			sectPos := fset.Position(pos)
 			sect, sectsub = lit, ""
 			pos, tok, lit = s.Scan()
 			if errs.Len() > 0 {
-				return errs.Err()
+				continue
 			}
 			if tok == token.STRING {
 				sectsub = unquote(lit)
 				if sectsub == "" {
-					return errfn("empty subsection name")
+					if err := report(errfn("empty subsection name")); err != nil {
+						return err
+					}
+					resync()
+					continue
 				}
 				pos, tok, lit = s.Scan()
 				if errs.Len() > 0 {
-					return errs.Err()
+					continue
 				}
 			}
 			if tok != token.RBRACK {
+				msg := "expected right bracket"
 				if sectsub == "" {
-					return errfn("expected subsection name or right bracket")
+					msg = "expected subsection name or right bracket"
 				}
-				return errfn("expected right bracket")
+				if err := report(errfn(msg)); err != nil {
+					return err
+				}
+				resync()
+				continue
 			}
 			pos, tok, lit = s.Scan()
 			if tok != token.EOL && tok != token.EOF && tok != token.COMMENT {
-				return errfn("expected EOL, EOF, or comment")
+				if err := report(errfn("expected EOL, EOF, or comment")); err != nil {
+					return err
+				}
+				resync()
+				continue
 			}
 			// If a section/subsection header was found, ensure a
 			// container object is created, even if there are no
 			// variables further down.
-			err := set(config, sect, sectsub, "", true, "")
-			if err != nil && processErrorAndMaybeStop(err) {
-				return err
+			if err := set(config, sect, sectsub, "", true, ""); err != nil {
+				if e := report(withPosition(err, sectPos)); e != nil {
+					return e
+				}
 			}
 		case token.IDENT:
 			if sect == "" {
-				return errfn("expected section header")
+				if err := report(errfn("expected section header")); err != nil {
+					return err
+				}
+				resync()
+				continue
 			}
+			namePos := fset.Position(pos)
 			n := lit
 			pos, tok, lit = s.Scan()
 			if errs.Len() > 0 {
-				return errs.Err()
+				continue
 			}
 			blank, v := tok == token.EOF || tok == token.EOL || tok == token.COMMENT, ""
 			if !blank {
 				if tok != token.ASSIGN {
-					return errfn("expected '='")
+					if err := report(errfn("expected '='")); err != nil {
+						return err
+					}
+					resync()
+					continue
 				}
 				pos, tok, lit = s.Scan()
 				if errs.Len() > 0 {
-					return errs.Err()
+					continue
 				}
 				if tok != token.STRING {
-					return errfn("expected value")
+					if err := report(errfn("expected value")); err != nil {
+						return err
+					}
+					resync()
+					continue
 				}
 				v = unquote(lit)
 				pos, tok, lit = s.Scan()
 				if errs.Len() > 0 {
-					return errs.Err()
+					continue
 				}
 				if tok != token.EOL && tok != token.EOF && tok != token.COMMENT {
-					return errfn("expected EOL, EOF, or comment")
+					if err := report(errfn("expected EOL, EOF, or comment")); err != nil {
+						return err
+					}
+					resync()
+					continue
 				}
 			}
-			err := set(config, sect, sectsub, n, blank, v)
-			if err != nil && processErrorAndMaybeStop(err) {
-				return err
+			if err := set(config, sect, sectsub, n, blank, v); err != nil {
+				if e := report(withPosition(err, namePos)); e != nil {
+					return e
+				}
 			}
 		default:
+			msg := "expected section header or variable declaration"
 			if sect == "" {
-				return errfn("expected section header")
+				msg = "expected section header"
+			}
+			if err := report(errfn(msg)); err != nil {
+				return err
 			}
-			return errfn("expected section header or variable declaration")
+			resync()
 		}
 	}
 	panic("never reached")
 }
 
-// ReadInto reads gcfg formatted data from reader and sets the values into the
+// parseBytesInto is the common implementation behind ParseBytesInto, ParseInto, and
+// ParseFileInto: name is recorded against the resulting positions (it is the empty string
+// unless the data came from a named file), and src is the data already read into memory.
+func parseBytesInto(config interface{}, name string, src []byte, options ...ReadOption) error {
+	fset := token.NewFileSet()
+	file := fset.AddFile(name, fset.Base(), len(src))
+	return readInto(config, fset, file, src, options...)
+}
+
+// ParseBytesInto parses gcfg formatted data from src and sets the values into the
 // corresponding fields in config.
 //
 // You may specify ReadOptions such as ErrorHandler if you want to modify the default
 // reading behavior. See ErrorHandler for a description of error-handling behavior.
-func ReadInto(config interface{}, reader io.Reader, options ...ReadOption) error {
-	src, err := ioutil.ReadAll(reader)
-	if err != nil {
-		return err
-	}
-	fset := token.NewFileSet()
-	file := fset.AddFile("", fset.Base(), len(src))
-	return readInto(config, fset, file, src, options...)
+func ParseBytesInto(config interface{}, src []byte, options ...ReadOption) error {
+	return parseBytesInto(config, "", src, options...)
 }
 
-// ReadStringInto reads gcfg formatted data from str and sets the values into
-// the corresponding fields in config.
+// ParseInto parses gcfg formatted data from reader and sets the values into the
+// corresponding fields in config.
 //
 // You may specify ReadOptions such as ErrorHandler if you want to modify the default
 // reading behavior. See ErrorHandler for a description of error-handling behavior.
-func ReadStringInto(config interface{}, str string, options ...ReadOption) error {
-	r := strings.NewReader(str)
-	return ReadInto(config, r, options...)
+//
+// ParseInto reads all of reader into memory with ioutil.ReadAll before parsing; it does not
+// consume reader incrementally, so the whole configuration data is held in memory at once.
+func ParseInto(config interface{}, reader io.Reader, options ...ReadOption) error {
+	src, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	return parseBytesInto(config, "", src, options...)
 }
 
-// ReadFileInto reads gcfg formatted data from the file filename and sets the
-// values into the corresponding fields in config.
+// ParseFileInto parses gcfg formatted data from the file filename and sets the values
+// into the corresponding fields in config.
 //
 // You may specify ReadOptions such as ErrorHandler if you want to modify the default
 // reading behavior. See ErrorHandler for a description of error-handling behavior.
-func ReadFileInto(config interface{}, filename string, options ...ReadOption) error {
+//
+// Like ParseInto, this does not stream: filename is opened and read into memory in full
+// before parsing begins.
+func ParseFileInto(config interface{}, filename string, options ...ReadOption) error {
 	f, err := os.Open(filename)
 	if err != nil {
 		return err
@@ -212,7 +310,33 @@ func ReadFileInto(config interface{}, filename string, options ...ReadOption) er
 	if err != nil {
 		return err
 	}
-	fset := token.NewFileSet()
-	file := fset.AddFile(filename, fset.Base(), len(src))
-	return readInto(config, fset, file, src, options...)
+	return parseBytesInto(config, filename, src, options...)
+}
+
+// ReadInto reads gcfg formatted data from reader and sets the values into the
+// corresponding fields in config.
+//
+// Deprecated: use ParseInto, which behaves identically. ReadInto is kept for backward
+// compatibility.
+func ReadInto(config interface{}, reader io.Reader, options ...ReadOption) error {
+	return ParseInto(config, reader, options...)
+}
+
+// ReadStringInto reads gcfg formatted data from str and sets the values into
+// the corresponding fields in config.
+//
+// Deprecated: use ParseInto with a strings.Reader, or ParseBytesInto with []byte(str).
+// ReadStringInto is kept for backward compatibility.
+func ReadStringInto(config interface{}, str string, options ...ReadOption) error {
+	r := strings.NewReader(str)
+	return ParseInto(config, r, options...)
+}
+
+// ReadFileInto reads gcfg formatted data from the file filename and sets the
+// values into the corresponding fields in config.
+//
+// Deprecated: use ParseFileInto, which behaves identically. ReadFileInto is kept for
+// backward compatibility.
+func ReadFileInto(config interface{}, filename string, options ...ReadOption) error {
+	return ParseFileInto(config, filename, options...)
 }
@@ -3,6 +3,7 @@ package gcfg
 type readOptions struct {
 	errorHandlers        []func(error) ErrorAction
 	stopOnTargetNotFound bool
+	collectErrors        bool
 }
 
 // ReadOption is a common interface for optional parameters that can be passed to
@@ -26,13 +27,14 @@ type ReadOption interface {
 // and then ignored; ValueError causes gcfg to stop and return the error, and
 // InvalidContainerError causes a panic.
 //
-// Two kinds of errors cannot be handled with ErrorHandler:
+// ParseError (and the underlying scanner errors for malformed input) are also passed
+// through the handler chain. By default they cause gcfg to stop and return the error, but
+// you can use ErrorHandler(SuppressParseErrors), or a custom handler that returns
+// ErrorActionSuppress for a ParseError, to have gcfg resync past the bad line and keep
+// parsing the rest of the file instead.
 //
-// 1. Errors that are due to an incorrectly formatted file, so that parsing cannot continue,
-// always cause the Read functions to immediately return the error (as a ParseError).
-//
-// 2. Passing a target interface{} value that is not a struct pointer to the Read functions
-// always causes a panic.
+// One kind of error cannot be handled with ErrorHandler: passing a target interface{}
+// value that is not a struct pointer to the Read functions always causes a panic.
 //
 //     func logAndSkipValueErrors(e error) gcfg.ErrorAction {
 //         if _, ok := e.(gcfg.ValueError); ok {
@@ -53,3 +55,28 @@ type readOptionErrorHandlers []func(error) ErrorAction
 func (o readOptionErrorHandlers) apply(ro *readOptions) {
 	ro.errorHandlers = o
 }
+
+// CollectErrors is an option for the Read functions which causes gcfg to keep parsing
+// after it encounters an error, instead of stopping at the first one. ParseErrors,
+// scanner errors, ValueErrors, and TargetNotFoundErrors (if StopOnTargetNotFound is also in
+// effect) are all accumulated into a single ErrorList, which is returned once the end of
+// the configuration data is reached.
+//
+// Without this option (the default), the Read functions stop and return the first error
+// they encounter, exactly as before.
+//
+//     err := gcfg.ReadFileInto(&configStruct, fileName, gcfg.CollectErrors())
+//     if errList, ok := err.(gcfg.ErrorList); ok {
+//         for _, e := range errList {
+//             fmt.Println(e)
+//         }
+//     }
+func CollectErrors() ReadOption {
+	return readOptionCollectErrors{}
+}
+
+type readOptionCollectErrors struct{}
+
+func (o readOptionCollectErrors) apply(ro *readOptions) {
+	ro.collectErrors = true
+}
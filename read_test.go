@@ -0,0 +1,49 @@
+package gcfg
+
+import "testing"
+
+type errorCollectionConfig struct {
+	Section struct {
+		Name string
+	}
+}
+
+const malformedInput = `
+[section]
+name = "first"
+not valid line
+[section]
+name = "second"
+`
+
+func TestCollectErrorsRecordsParseErrorAndResumes(t *testing.T) {
+	var cfg errorCollectionConfig
+	err := ReadStringInto(&cfg, malformedInput, CollectErrors())
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	errList, ok := err.(ErrorList)
+	if !ok {
+		t.Fatalf("expected ErrorList, got %T: %v", err, err)
+	}
+	if len(errList) != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", len(errList), errList)
+	}
+	if _, ok := errList[0].(ParseError); !ok {
+		t.Errorf("expected errList[0] to be a ParseError, got %T: %v", errList[0], errList[0])
+	}
+	if cfg.Section.Name != "second" {
+		t.Errorf("expected parsing to resume past the bad line, got Name=%q", cfg.Section.Name)
+	}
+}
+
+func TestSuppressParseErrorsResumesParsing(t *testing.T) {
+	var cfg errorCollectionConfig
+	err := ReadStringInto(&cfg, malformedInput, ErrorHandler(SuppressParseErrors))
+	if err != nil {
+		t.Fatalf("expected SuppressParseErrors to suppress the ParseError, got: %v", err)
+	}
+	if cfg.Section.Name != "second" {
+		t.Errorf("expected parsing to resume past the bad line, got Name=%q", cfg.Section.Name)
+	}
+}